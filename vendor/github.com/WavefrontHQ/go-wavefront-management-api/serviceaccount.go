@@ -0,0 +1,336 @@
+package wavefront
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// ServiceAccount represents a Wavefront service account.
+// Service accounts authenticate with API tokens rather than a human login,
+// and are typically used to grant access to automation such as CI pipelines.
+// Tokens are managed separately via ServiceAccountToken/ServiceAccounts.Tokens/
+// ServiceAccounts.GenerateToken, not as a field on this struct
+type ServiceAccount struct {
+	// The identifier for a service account, e.g. "sa::my-ci-pipeline"
+	ID *string `json:"identifier"`
+
+	// A human-readable description of what this service account is used for
+	Description string `json:"description,omitempty"`
+
+	// The permissions granted to this service account
+	Permissions []string `json:"groups,omitempty"`
+
+	// Groups this service account belongs to
+	// This is wrapped with a Wrapper to manage the serialization between what we send to the API
+	// And what the API sends back (which is to say, we send just IDs but we always receive a complete object)
+	Groups UserGroupsWrapper `json:"userGroups,omitempty"`
+
+	// Whether the service account is active. Deactivated service accounts cannot authenticate.
+	// Not omitempty: false is a meaningful, transmittable value here, not an unset field
+	Active bool `json:"active"`
+}
+
+// ServiceAccounts is used to perform service-account-related operations against the Wavefront API
+type ServiceAccounts struct {
+	// client is the Wavefront client used to perform target-related operations
+	client Wavefronter
+}
+
+const baseServiceAccountPath = "/api/v2/account/serviceaccount"
+
+// ServiceAccounts is used to return a client for service-account-related operations
+func (c *Client) ServiceAccounts() *ServiceAccounts {
+	return &ServiceAccounts{client: c}
+}
+
+// Get is used to retrieve an existing ServiceAccount by ID.
+// The identifier field must be specified
+func (s ServiceAccounts) Get(serviceAccount *ServiceAccount) error {
+	if *serviceAccount.ID == "" {
+		return fmt.Errorf("service account ID field is not set")
+	}
+
+	return s.updateServiceAccount("GET", fmt.Sprintf("%s/%s", baseServiceAccountPath, *serviceAccount.ID), serviceAccount)
+}
+
+// Find returns all ServiceAccounts filtered by the given search conditions.
+// If filter is nil, all ServiceAccounts are returned.
+// On error, Find returns a nil slice, discarding any ServiceAccounts paged in
+// before the error occurred
+func (s ServiceAccounts) Find(filter []*SearchCondition) ([]*ServiceAccount, error) {
+	it := s.FindIter(filter)
+
+	var results []*ServiceAccount
+	for it.Next() {
+		results = append(results, it.ServiceAccount())
+	}
+
+	if it.Err() != nil {
+		return nil, it.Err()
+	}
+
+	return results, nil
+}
+
+// FindIter returns a ServiceAccountIterator that lazily fetches ServiceAccounts
+// matching the given search conditions, one page at a time. If filter is nil,
+// all ServiceAccounts are iterated.
+func (s ServiceAccounts) FindIter(filter []*SearchCondition) *ServiceAccountIterator {
+	return &ServiceAccountIterator{
+		search: &Search{
+			client: s.client,
+			Type:   "serviceaccount",
+			Params: &SearchParams{
+				Conditions: filter,
+			},
+		},
+	}
+}
+
+// ServiceAccountIterator lazily pages through the results of a ServiceAccounts.Find search
+type ServiceAccountIterator struct {
+	search  *Search
+	items   []*ServiceAccount
+	idx     int
+	more    bool
+	fetched bool
+	err     error
+}
+
+// Next advances the iterator to the next ServiceAccount, fetching the next page
+// from the API if the current page has been exhausted. It returns false once
+// there are no more ServiceAccounts or an error has occurred
+func (it *ServiceAccountIterator) Next() bool {
+	it.idx++
+	if it.idx < len(it.items) {
+		return true
+	}
+
+	if it.err != nil || (it.fetched && !it.more) {
+		return false
+	}
+
+	resp, err := it.search.Execute()
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	var page []*ServiceAccount
+	if err := json.Unmarshal(resp.Response.Items, &page); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.fetched = true
+	it.items = page
+	it.idx = 0
+	it.more = resp.Response.MoreItems
+	it.search.Params.Offset = resp.NextOffset
+
+	return len(it.items) > 0
+}
+
+// ServiceAccount returns the ServiceAccount at the iterator's current position.
+// It must only be called after a call to Next has returned true
+func (it *ServiceAccountIterator) ServiceAccount() *ServiceAccount {
+	if it.idx < 0 || it.idx >= len(it.items) {
+		return nil
+	}
+	return it.items[it.idx]
+}
+
+// Err returns the first error, if any, encountered while paging through results
+func (it *ServiceAccountIterator) Err() error {
+	return it.err
+}
+
+// Create is used to create a new ServiceAccount.
+// The identifier field must be specified
+func (s ServiceAccounts) Create(serviceAccount *ServiceAccount) error {
+	if *serviceAccount.ID == "" {
+		return fmt.Errorf("service account ID field is not set")
+	}
+
+	return s.updateServiceAccount("POST", baseServiceAccountPath, serviceAccount)
+}
+
+// Update is used to modify an existing ServiceAccount.
+// The identifier field must be specified
+func (s ServiceAccounts) Update(serviceAccount *ServiceAccount) error {
+	if *serviceAccount.ID == "" {
+		return fmt.Errorf("service account ID field is not set")
+	}
+
+	return s.updateServiceAccount("PUT", fmt.Sprintf("%s/%s", baseServiceAccountPath, *serviceAccount.ID), serviceAccount)
+}
+
+// Delete deletes the specified ServiceAccount
+// The ID field must be specified
+func (s ServiceAccounts) Delete(serviceAccount *ServiceAccount) error {
+	if *serviceAccount.ID == "" {
+		return fmt.Errorf("service account ID field is not set")
+	}
+
+	req, err := s.client.NewRequest("DELETE",
+		fmt.Sprintf("%s/%s", baseServiceAccountPath, *serviceAccount.ID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	*serviceAccount.ID = ""
+	return nil
+}
+
+// Activate re-enables a previously deactivated ServiceAccount so it can authenticate again.
+// The ID field must be specified
+func (s ServiceAccounts) Activate(serviceAccount *ServiceAccount) error {
+	if *serviceAccount.ID == "" {
+		return fmt.Errorf("service account ID field is not set")
+	}
+
+	return s.updateServiceAccount("POST", fmt.Sprintf("%s/%s/activate", baseServiceAccountPath, *serviceAccount.ID), serviceAccount)
+}
+
+// Deactivate disables a ServiceAccount, preventing it from authenticating, without deleting it.
+// The ID field must be specified
+func (s ServiceAccounts) Deactivate(serviceAccount *ServiceAccount) error {
+	if *serviceAccount.ID == "" {
+		return fmt.Errorf("service account ID field is not set")
+	}
+
+	return s.updateServiceAccount("POST", fmt.Sprintf("%s/%s/deactivate", baseServiceAccountPath, *serviceAccount.ID), serviceAccount)
+}
+
+// ServiceAccountToken is an API token belonging to a ServiceAccount.
+// Value is only ever populated on the token returned by GenerateToken - the
+// Wavefront API reveals a token's secret value once, at creation time, and
+// Tokens thereafter reports metadata only
+type ServiceAccountToken struct {
+	ID    string `json:"id"`
+	Value string `json:"value,omitempty"`
+}
+
+// Tokens lists the API tokens belonging to the ServiceAccount.
+// The ID field must be specified. The returned tokens do not carry their
+// secret Value - use GenerateToken to mint a token and obtain its value
+func (s ServiceAccounts) Tokens(serviceAccount *ServiceAccount) ([]*ServiceAccountToken, error) {
+	if *serviceAccount.ID == "" {
+		return nil, fmt.Errorf("service account ID field is not set")
+	}
+
+	req, err := s.client.NewRequest("GET",
+		fmt.Sprintf("%s/%s/tokens", baseServiceAccountPath, *serviceAccount.ID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+
+	body, err := ioutil.ReadAll(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Response []*ServiceAccountToken `json:"response"`
+	}
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Response, nil
+}
+
+// GenerateToken mints a new API token for the ServiceAccount and returns it
+// with its secret Value populated. This is the only call that ever returns
+// the token's Value - store it immediately, as it cannot be retrieved again.
+// The ID field must be specified
+func (s ServiceAccounts) GenerateToken(serviceAccount *ServiceAccount) (*ServiceAccountToken, error) {
+	if *serviceAccount.ID == "" {
+		return nil, fmt.Errorf("service account ID field is not set")
+	}
+
+	req, err := s.client.NewRequest("POST",
+		fmt.Sprintf("%s/%s/tokens", baseServiceAccountPath, *serviceAccount.ID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+
+	body, err := ioutil.ReadAll(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Response *ServiceAccountToken `json:"response"`
+	}
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Response, nil
+}
+
+// DeleteToken revokes the API token identified by tokenID from the ServiceAccount.
+// The ID field must be specified
+func (s ServiceAccounts) DeleteToken(serviceAccount *ServiceAccount, tokenID string) error {
+	if *serviceAccount.ID == "" {
+		return fmt.Errorf("service account ID field is not set")
+	}
+
+	req, err := s.client.NewRequest("DELETE",
+		fmt.Sprintf("%s/%s/tokens/%s", baseServiceAccountPath, *serviceAccount.ID, tokenID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	return nil
+}
+
+func (s ServiceAccounts) updateServiceAccount(method, path string, serviceAccount *ServiceAccount) error {
+	payload, err := json.Marshal(serviceAccount)
+	if err != nil {
+		return err
+	}
+	req, err := s.client.NewRequest(method, path, nil, payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	body, err := ioutil.ReadAll(resp)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, &serviceAccount)
+}
@@ -86,36 +86,156 @@ func (u Users) Get(user *User) error {
 
 // Find returns all Users filtered by the given search conditions.
 // If filter is nil, all Users are returned.
-// UserGroups returned on the User from this call will be ID only
+// UserGroups returned on the User from this call will be ID only.
+// On error, Find returns a nil slice, discarding any Users paged in before
+// the error occurred
 func (u Users) Find(filter []*SearchCondition) ([]*User, error) {
-	search := &Search{
-		client: u.client,
-		Type:   "user",
-		Params: &SearchParams{
-			Conditions: filter,
-		},
-	}
+	it := u.FindIter(filter)
 
 	var results []*User
-	moreItems := true
-	for moreItems == true {
-		resp, err := search.Execute()
-		if err != nil {
-			return nil, err
-		}
-		var tmpres []*User
-		err = json.Unmarshal(resp.Response.Items, &tmpres)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, tmpres...)
-		moreItems = resp.Response.MoreItems
-		search.Params.Offset = resp.NextOffset
+	for it.Next() {
+		results = append(results, it.User())
+	}
+
+	if it.Err() != nil {
+		return nil, it.Err()
 	}
 
 	return results, nil
 }
 
+// FindIter returns a UserIterator that lazily fetches Users matching the given
+// search conditions, one page at a time, so large tenants don't have to be
+// loaded into memory all at once. If filter is nil, all Users are iterated.
+func (u Users) FindIter(filter []*SearchCondition) *UserIterator {
+	return &UserIterator{
+		search: &Search{
+			client: u.client,
+			Type:   "user",
+			Params: &SearchParams{
+				Conditions: filter,
+			},
+		},
+	}
+}
+
+// UserIterator lazily pages through the results of a Users.Find search
+type UserIterator struct {
+	search  *Search
+	items   []*User
+	idx     int
+	more    bool
+	fetched bool
+	err     error
+}
+
+// Next advances the iterator to the next User, fetching the next page from
+// the API if the current page has been exhausted. It returns false once
+// there are no more Users or an error has occurred
+func (it *UserIterator) Next() bool {
+	it.idx++
+	if it.idx < len(it.items) {
+		return true
+	}
+
+	if it.err != nil || (it.fetched && !it.more) {
+		return false
+	}
+
+	resp, err := it.search.Execute()
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	var page []*User
+	if err := json.Unmarshal(resp.Response.Items, &page); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.fetched = true
+	it.items = page
+	it.idx = 0
+	it.more = resp.Response.MoreItems
+	it.search.Params.Offset = resp.NextOffset
+
+	return len(it.items) > 0
+}
+
+// User returns the User at the iterator's current position.
+// It must only be called after a call to Next has returned true
+func (it *UserIterator) User() *User {
+	if it.idx < 0 || it.idx >= len(it.items) {
+		return nil
+	}
+	return it.items[it.idx]
+}
+
+// Err returns the first error, if any, encountered while paging through results
+func (it *UserIterator) Err() error {
+	return it.err
+}
+
+// FindByEmail returns the User whose identifier exactly matches email.
+// It returns an error if no such User exists
+func (u Users) FindByEmail(email string) (*User, error) {
+	users, err := u.Find([]*SearchCondition{
+		{
+			Key:            "identifier",
+			Value:          email,
+			MatchingMethod: "EXACT",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("no user found with email %s", email)
+	}
+
+	return users[0], nil
+}
+
+// FindByGroup returns all Users that are a member of the UserGroup identified by groupID
+func (u Users) FindByGroup(groupID string) ([]*User, error) {
+	return u.Find([]*SearchCondition{
+		{
+			Key:            "userGroups",
+			Value:          groupID,
+			MatchingMethod: "EXACT",
+		},
+	})
+}
+
+// FindByPermission returns all Users that have been granted perm.
+// perm must be one of the permission constants declared in this file
+func (u Users) FindByPermission(perm string) ([]*User, error) {
+	if !isValidPermission(perm) {
+		return nil, fmt.Errorf("%s is not a valid permission", perm)
+	}
+
+	return u.Find([]*SearchCondition{
+		{
+			Key:            "groups",
+			Value:          perm,
+			MatchingMethod: "EXACT",
+		},
+	})
+}
+
+func isValidPermission(perm string) bool {
+	switch perm {
+	case AGENT_MANAGEMENT, ALERTS_MANAGEMENT, DASHBOARD_MANAGEMENT, EMBEDDED_CHARTS_MANAGEMENT,
+		EVENTS_MANAGEMENT, EXTERNAL_LINKS_MANAGEMENT, HOST_TAG_MANAGEMENT, METRICS_MANAGEMENT,
+		USER_MANAGEMENT, INTEGRATIONS_MANAGEMENT, DIRECT_INGESTION, BATCH_QUERY_PRIORITY,
+		DERIVED_METRICS_MANAGEMENT:
+		return true
+	default:
+		return false
+	}
+}
+
 // Does not support specifying a credential
 // The EmailAddress field must be specified
 func (u Users) Create(newUser *NewUserRequest, user *User, sendEmail bool) error {
@@ -187,6 +307,234 @@ func (u Users) Delete(user *User) error {
 	return nil
 }
 
+// Invite creates many Users in a single call.
+// The EmailAddress field must be specified on each NewUserRequest
+func (u Users) Invite(newUsers []*NewUserRequest, sendEmail bool) ([]*User, error) {
+	for _, newUser := range newUsers {
+		if newUser.EmailAddress == "" {
+			return nil, fmt.Errorf("a valid email address must be specified")
+		}
+	}
+
+	params := map[string]string{
+		"sendEmail": fmt.Sprintf("%t", sendEmail),
+	}
+
+	payload, err := json.Marshal(newUsers)
+	if err != nil {
+		return nil, err
+	}
+	req, err := u.client.NewRequest("POST", fmt.Sprintf("%s/invite", baseUserPath), &params, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+
+	body, err := ioutil.ReadAll(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Response []*User `json:"response"`
+	}
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Response, nil
+}
+
+// DeleteMany deletes many Users in a single call, by ID
+func (u Users) DeleteMany(ids []string) error {
+	payload, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	req, err := u.client.NewRequest("POST", fmt.Sprintf("%s/deleteUsers", baseUserPath), nil, payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	return nil
+}
+
+// GrantPermissions grants each of perms to every User in ids, one API call per permission.
+// perms must each be one of the permission constants declared in this file
+func (u Users) GrantPermissions(ids []string, perms []string) error {
+	_, err := u.updatePermissions("grant", ids, perms)
+	return err
+}
+
+// RevokePermissions revokes each of perms from every User in ids, one API call per permission.
+// perms must each be one of the permission constants declared in this file
+func (u Users) RevokePermissions(ids []string, perms []string) error {
+	_, err := u.updatePermissions("revoke", ids, perms)
+	return err
+}
+
+// AddPermissions grants perms to the User identified by id, returning the
+// refreshed User so the caller can observe the authoritative state without a
+// second Get. It is the single-id counterpart of GrantPermissions.
+// perms must each be one of the permission constants declared in this file.
+// An empty perms simply returns the User's current state
+func (u Users) AddPermissions(id string, perms []string) (*User, error) {
+	if len(perms) == 0 {
+		return u.getByID(id)
+	}
+
+	users, err := u.updatePermissions("grant", []string{id}, perms)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("no user found with id %s", id)
+	}
+
+	return users[0], nil
+}
+
+// RemovePermissions revokes perms from the User identified by id, returning
+// the refreshed User. It is the single-id counterpart of RevokePermissions.
+// perms must each be one of the permission constants declared in this file.
+// An empty perms simply returns the User's current state
+func (u Users) RemovePermissions(id string, perms []string) (*User, error) {
+	if len(perms) == 0 {
+		return u.getByID(id)
+	}
+
+	users, err := u.updatePermissions("revoke", []string{id}, perms)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("no user found with id %s", id)
+	}
+
+	return users[0], nil
+}
+
+// getByID fetches the User identified by id
+func (u Users) getByID(id string) (*User, error) {
+	user := &User{ID: &id}
+	if err := u.Get(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// updatePermissions grants or revokes each of perms for the given User ids, one
+// API call per permission, returning the refreshed Users as reported by the API.
+// It is the shared core behind GrantPermissions/RevokePermissions and their
+// single-id AddPermissions/RemovePermissions counterparts
+func (u Users) updatePermissions(action string, ids []string, perms []string) ([]*User, error) {
+	for _, perm := range perms {
+		if !isValidPermission(perm) {
+			return nil, fmt.Errorf("%s is not a valid permission", perm)
+		}
+	}
+
+	var users []*User
+	for _, perm := range perms {
+		var err error
+		users, err = u.updatePermission(action, perm, ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return users, nil
+}
+
+// updatePermission grants or revokes a single permission for the given User ids,
+// returning the refreshed Users as reported by the API
+func (u Users) updatePermission(action, perm string, ids []string) ([]*User, error) {
+	payload, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+	req, err := u.client.NewRequest("POST", fmt.Sprintf("%s/%s/%s", baseUserPath, action, perm), nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+
+	body, err := ioutil.ReadAll(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Response []*User `json:"response"`
+	}
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Response, nil
+}
+
+// AddToGroups adds the User identified by id to groupIDs, returning the refreshed User
+func (u Users) AddToGroups(id string, groupIDs []string) (*User, error) {
+	return u.updateGroups("addUserGroups", id, groupIDs)
+}
+
+// RemoveFromGroups removes the User identified by id from groupIDs
+func (u Users) RemoveFromGroups(id string, groupIDs []string) error {
+	_, err := u.updateGroups("removeUserGroups", id, groupIDs)
+	return err
+}
+
+// updateGroups adds or removes the given UserGroup ids from the User identified
+// by id, returning the refreshed User as reported by the API
+func (u Users) updateGroups(action, id string, groupIDs []string) (*User, error) {
+	payload, err := json.Marshal(groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	req, err := u.client.NewRequest("POST", fmt.Sprintf("%s/%s/%s", baseUserPath, id, action), nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+
+	body, err := ioutil.ReadAll(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Response *User `json:"response"`
+	}
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Response, nil
+}
+
 func (u Users) updateUser(method, path string, params *map[string]string, user *User) error {
 	payload, err := json.Marshal(user)
 	if err != nil {